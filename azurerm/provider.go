@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns the azurerm Terraform provider.
+//
+// NOTE: this checkout only contains the Logic App resources - the rest of the provider's
+// registrations (compute, storage, networking, etc.) live outside this tree, along with the
+// provider `Schema`/`ConfigureFunc` that build the `ArmClient` these resources' CRUD funcs
+// read via `meta.(*ArmClient)`. Merge `logicAppResourcesMap()` into that provider's
+// `ResourcesMap` alongside the rest of the registrations rather than shipping this `Provider()`
+// as-is.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		ResourcesMap: logicAppResourcesMap(),
+	}
+}
+
+// logicAppResourcesMap is the Logic App subset of the provider's `ResourcesMap`.
+func logicAppResourcesMap() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_logic_app_workflow":           resourceArmLogicAppWorkflow(),
+		"azurerm_logic_app_action_custom":      resourceArmLogicAppActionCustom(),
+		"azurerm_logic_app_action_http":        resourceArmLogicAppActionHTTP(),
+		"azurerm_logic_app_trigger_custom":     resourceArmLogicAppTriggerCustom(),
+		"azurerm_logic_app_trigger_recurrence": resourceArmLogicAppTriggerRecurrence(),
+	}
+}
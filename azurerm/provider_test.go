@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestProvider_impl(t *testing.T) {
+	var _ = Provider()
+}
+
+func TestProvider_logicAppResourcesRegistered(t *testing.T) {
+	provider, ok := Provider().(*schema.Provider)
+	if !ok {
+		t.Fatalf("Expected `Provider()` to return a `*schema.Provider`")
+	}
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatalf("Error validating provider: %+v", err)
+	}
+
+	resourcesMap := logicAppResourcesMap()
+	for _, name := range []string{
+		"azurerm_logic_app_workflow",
+		"azurerm_logic_app_action_custom",
+		"azurerm_logic_app_action_http",
+		"azurerm_logic_app_trigger_custom",
+		"azurerm_logic_app_trigger_recurrence",
+	} {
+		if _, ok := resourcesMap[name]; !ok {
+			t.Fatalf("Expected %q to be registered in `logicAppResourcesMap`", name)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package azurerm
+
+import "testing"
+
+func TestResourceArmLogicAppActionCustom_schema(t *testing.T) {
+	resource := resourceArmLogicAppActionCustom()
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Error validating resource schema: %+v", err)
+	}
+}
+
+func TestLogicAppComponentID_roundTrip(t *testing.T) {
+	workflowID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Logic/workflows/workflow1"
+
+	id := logicAppComponentID(workflowID, "actions", "custom1")
+
+	actualWorkflowID, name, err := parseLogicAppComponentID(id, "actions")
+	if err != nil {
+		t.Fatalf("Error parsing Logic App Component ID: %+v", err)
+	}
+
+	if actualWorkflowID != workflowID {
+		t.Fatalf("Expected Workflow ID %q but got %q", workflowID, actualWorkflowID)
+	}
+
+	if name != "custom1" {
+		t.Fatalf("Expected name %q but got %q", "custom1", name)
+	}
+}
+
+func TestParseLogicAppComponentID_wrongComponentType(t *testing.T) {
+	workflowID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Logic/workflows/workflow1"
+	id := logicAppComponentID(workflowID, "actions", "custom1")
+
+	if _, _, err := parseLogicAppComponentID(id, "triggers"); err == nil {
+		t.Fatalf("Expected an error parsing an `actions` ID as a `triggers` ID, got none")
+	}
+}
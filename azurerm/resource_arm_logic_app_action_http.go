@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppActionHTTP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppActionHTTPCreateUpdate,
+		Read:   resourceArmLogicAppActionHTTPRead,
+		Update: resourceArmLogicAppActionHTTPCreateUpdate,
+		Delete: resourceArmLogicAppActionHTTPDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DELETE", "GET", "PATCH", "POST", "PUT",
+				}, false),
+			},
+
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppActionHTTPCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	workflowID := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, actions, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "actions")
+	if err != nil {
+		return err
+	}
+
+	inputs := map[string]interface{}{
+		"method": d.Get("method").(string),
+		"uri":    d.Get("uri").(string),
+	}
+
+	if body := d.Get("body").(string); body != "" {
+		inputs["body"] = body
+	}
+
+	if headers := d.Get("headers").(map[string]interface{}); len(headers) > 0 {
+		inputs["headers"] = headers
+	}
+
+	actions[name] = map[string]interface{}{
+		"type":     "Http",
+		"inputs":   inputs,
+		"runAfter": map[string]interface{}{},
+	}
+
+	if err := updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "actions", actions); err != nil {
+		return err
+	}
+
+	d.SetId(logicAppComponentID(workflowID, "actions", name))
+
+	return resourceArmLogicAppActionHTTPRead(d, meta)
+}
+
+func resourceArmLogicAppActionHTTPRead(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "actions")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	_, _, actions, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "actions")
+	if err != nil {
+		return err
+	}
+
+	action, ok := actions[name].(map[string]interface{})
+	if !ok {
+		log.Printf("[DEBUG] Logic App HTTP Action %q was not found in Workflow %q - removing from state", name, workflowName)
+		d.SetId("")
+		return nil
+	}
+
+	inputs, ok := action["inputs"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("[ERROR] Error parsing Logic App HTTP Action %q - `inputs` was not a map", name)
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", workflowID)
+
+	if method, ok := inputs["method"].(string); ok {
+		d.Set("method", method)
+	}
+
+	if uri, ok := inputs["uri"].(string); ok {
+		d.Set("uri", uri)
+	}
+
+	if body, ok := inputs["body"].(string); ok {
+		d.Set("body", body)
+	}
+
+	if headers, ok := inputs["headers"].(map[string]interface{}); ok {
+		if err := d.Set("headers", headers); err != nil {
+			return fmt.Errorf("Error flattening `headers`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppActionHTTPDelete(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "actions")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, actions, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "actions")
+	if err != nil {
+		return err
+	}
+
+	delete(actions, name)
+
+	return updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "actions", actions)
+}
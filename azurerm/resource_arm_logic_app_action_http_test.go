@@ -0,0 +1,10 @@
+package azurerm
+
+import "testing"
+
+func TestResourceArmLogicAppActionHTTP_schema(t *testing.T) {
+	resource := resourceArmLogicAppActionHTTP()
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Error validating resource schema: %+v", err)
+	}
+}
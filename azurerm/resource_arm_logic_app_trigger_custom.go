@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppTriggerCustom() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppTriggerCustomCreateUpdate,
+		Read:   resourceArmLogicAppTriggerCustomRead,
+		Update: resourceArmLogicAppTriggerCustomCreateUpdate,
+		Delete: resourceArmLogicAppTriggerCustomDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppTriggerCustomCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	workflowID := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+	body := d.Get("body").(string)
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		return fmt.Errorf("[ERROR] Error unmarshalling `body` for Logic App Trigger Custom %q: %+v", name, err)
+	}
+	triggers[name] = value
+
+	if err := updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "triggers", triggers); err != nil {
+		return err
+	}
+
+	d.SetId(logicAppComponentID(workflowID, "triggers", name))
+
+	return resourceArmLogicAppTriggerCustomRead(d, meta)
+}
+
+func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "triggers")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	_, _, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	value, ok := triggers[name]
+	if !ok {
+		log.Printf("[DEBUG] Logic App Trigger Custom %q was not found in Workflow %q - removing from state", name, workflowName)
+		d.SetId("")
+		return nil
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error marshalling `body` for Logic App Trigger Custom %q: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", workflowID)
+	d.Set("body", string(body))
+
+	return nil
+}
+
+func resourceArmLogicAppTriggerCustomDelete(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "triggers")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	delete(triggers, name)
+
+	return updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "triggers", triggers)
+}
@@ -0,0 +1,10 @@
+package azurerm
+
+import "testing"
+
+func TestResourceArmLogicAppTriggerCustom_schema(t *testing.T) {
+	resource := resourceArmLogicAppTriggerCustom()
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Error validating resource schema: %+v", err)
+	}
+}
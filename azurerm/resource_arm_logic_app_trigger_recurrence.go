@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Read:   resourceArmLogicAppTriggerRecurrenceRead,
+		Update: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Delete: resourceArmLogicAppTriggerRecurrenceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Month", "Week", "Day", "Hour", "Minute", "Second",
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppTriggerRecurrenceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	workflowID := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	recurrence := map[string]interface{}{
+		"frequency": d.Get("frequency").(string),
+		"interval":  d.Get("interval").(int),
+	}
+
+	if startTime := d.Get("start_time").(string); startTime != "" {
+		recurrence["startTime"] = startTime
+	}
+
+	if timeZone := d.Get("time_zone").(string); timeZone != "" {
+		recurrence["timeZone"] = timeZone
+	}
+
+	triggers[name] = map[string]interface{}{
+		"type":       "Recurrence",
+		"recurrence": recurrence,
+	}
+
+	if err := updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "triggers", triggers); err != nil {
+		return err
+	}
+
+	d.SetId(logicAppComponentID(workflowID, "triggers", name))
+
+	return resourceArmLogicAppTriggerRecurrenceRead(d, meta)
+}
+
+func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "triggers")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	_, _, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	trigger, ok := triggers[name].(map[string]interface{})
+	if !ok {
+		log.Printf("[DEBUG] Logic App Recurrence Trigger %q was not found in Workflow %q - removing from state", name, workflowName)
+		d.SetId("")
+		return nil
+	}
+
+	recurrence, ok := trigger["recurrence"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("[ERROR] Error parsing Logic App Recurrence Trigger %q - `recurrence` was not a map", name)
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", workflowID)
+
+	if frequency, ok := recurrence["frequency"].(string); ok {
+		d.Set("frequency", frequency)
+	}
+
+	if interval, ok := recurrence["interval"].(float64); ok {
+		d.Set("interval", int(interval))
+	}
+
+	if startTime, ok := recurrence["startTime"].(string); ok {
+		d.Set("start_time", startTime)
+	}
+
+	if timeZone, ok := recurrence["timeZone"].(string); ok {
+		d.Set("time_zone", timeZone)
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppTriggerRecurrenceDelete(d *schema.ResourceData, meta interface{}) error {
+	workflowID, name, err := parseLogicAppComponentID(d.Id(), "triggers")
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(workflowID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workflowName := id.Path["workflows"]
+
+	// lock to prevent against Actions, Parameters or Triggers conflicting
+	azureRMLockByName(workflowName, logicAppResourceName)
+	defer azureRMUnlockByName(workflowName, logicAppResourceName)
+
+	workflow, definition, triggers, err := retrieveLogicAppWorkflowComponent(meta, resourceGroup, workflowName, "triggers")
+	if err != nil {
+		return err
+	}
+
+	delete(triggers, name)
+
+	return updateLogicAppWorkflowComponent(meta, resourceGroup, workflowName, workflow, definition, "triggers", triggers)
+}
@@ -0,0 +1,10 @@
+package azurerm
+
+import "testing"
+
+func TestResourceArmLogicAppTriggerRecurrence_schema(t *testing.T) {
+	resource := resourceArmLogicAppTriggerRecurrence()
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Error validating resource schema: %+v", err)
+	}
+}
@@ -1,18 +1,27 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var logicAppResourceName = "azurerm_logic_app"
 
-// azurerm_logic_app_action_custom
-// azurerm_logic_app_trigger_custom
+// other Logic App resources (azurerm_logic_app_action_custom, azurerm_logic_app_action_http,
+// azurerm_logic_app_trigger_custom, azurerm_logic_app_trigger_recurrence) are child resources
+// of this Workflow - they each perform a read-modify-write against the `actions`/`triggers`
+// keys of the Definition below, guarded by the same `logicAppResourceName` mutex.
 // azurerm_logic_app_condition_custom?
 
 func resourceArmLogicAppWorkflow() *schema.Resource {
@@ -25,6 +34,9 @@ func resourceArmLogicAppWorkflow() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourceArmLogicAppWorkflowMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -36,24 +48,108 @@ func resourceArmLogicAppWorkflow() *schema.Resource {
 
 			"resource_group_name": resourceGroupNameSchema(),
 
-			// TODO: should Parameters be split out into their own object to allow validation on the different sub-types?
-			"parameters": {
-				Type:     schema.TypeMap,
+			"parameter": {
+				Type:     schema.TypeSet,
 				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(logic.ParameterTypeArray),
+								string(logic.ParameterTypeBool),
+								string(logic.ParameterTypeFloat),
+								string(logic.ParameterTypeInt),
+								string(logic.ParameterTypeObject),
+								string(logic.ParameterTypeSecureObject),
+								string(logic.ParameterTypeSecureString),
+								string(logic.ParameterTypeString),
+							}, false),
+						},
+
+						"string_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"secure_string_value": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+
+						"int_value": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"float_value": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						"bool_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"array_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+
+						"object_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+
+						"secure_object_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+					},
+				},
 			},
 
 			"workflow_schema": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+				ConflictsWith: []string{"definition"},
 			},
 
 			"workflow_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "1.0.0.0",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       "1.0.0.0",
+				ConflictsWith: []string{"definition"},
+			},
+
+			// `definition` is an escape hatch for users who want to author a Workflow from an
+			// exported ARM template/Visual Studio designer file rather than composing it from
+			// `azurerm_logic_app_action_*`/`azurerm_logic_app_trigger_*` child resources - the
+			// two approaches can't be mixed, see the conflict check in the Update function.
+			// It also owns `$schema`/`contentVersion` itself (see the Read function), so it
+			// conflicts with `workflow_schema`/`workflow_version` - mixing them risks a
+			// permanent ForceNew recreate loop if the values disagree.
+			"definition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressJSONDiff,
+				ConflictsWith:    []string{"workflow_schema", "workflow_version"},
 			},
 
 			"tags": tagsSchema(),
@@ -75,27 +171,40 @@ func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
-	parameters := expandLogicAppWorkflowParameters(d)
+	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameter").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error expanding `parameter`: %+v", err)
+	}
 
 	workflowSchema := d.Get("workflow_schema").(string)
 	workflowVersion := d.Get("workflow_version").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	definition := &map[string]interface{}{
+		"$schema":        workflowSchema,
+		"contentVersion": workflowVersion,
+		"actions":        make(map[string]interface{}, 0),
+		"triggers":       make(map[string]interface{}, 0),
+	}
+
+	if v, ok := d.GetOk("definition"); ok {
+		var parsed map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &parsed); err != nil {
+			return fmt.Errorf("[ERROR] Error unmarshalling `definition`: %+v", err)
+		}
+		definition = &parsed
+	}
+
 	properties := logic.Workflow{
 		Location: utils.String(location),
 		WorkflowProperties: &logic.WorkflowProperties{
-			Definition: &map[string]interface{}{
-				"$schema":        workflowSchema,
-				"contentVersion": workflowVersion,
-				"actions":        make(map[string]interface{}, 0),
-				"triggers":       make(map[string]interface{}, 0),
-			},
+			Definition: definition,
 			Parameters: parameters,
 		},
 		Tags: expandTags(tags),
 	}
 
-	_, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
+	_, err = client.CreateOrUpdate(ctx, resourceGroup, name, properties)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -144,13 +253,40 @@ func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
-	parameters := expandLogicAppWorkflowParameters(d)
+	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameter").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error expanding `parameter`: %+v", err)
+	}
 	tags := d.Get("tags").(map[string]interface{})
 
+	definition := read.WorkflowProperties.Definition
+
+	if v, ok := d.GetOk("definition"); ok {
+		var parsed map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &parsed); err != nil {
+			return fmt.Errorf("[ERROR] Error unmarshalling `definition`: %+v", err)
+		}
+
+		previous := map[string]interface{}{}
+		if old, _ := d.GetChange("definition"); old.(string) != "" {
+			if err := json.Unmarshal([]byte(old.(string)), &previous); err != nil {
+				return fmt.Errorf("[ERROR] Error unmarshalling previous `definition`: %+v", err)
+			}
+		}
+
+		if remote, ok := read.WorkflowProperties.Definition.(map[string]interface{}); ok {
+			if err := validateLogicAppWorkflowDefinitionConflict(remote, previous, parsed); err != nil {
+				return err
+			}
+		}
+
+		definition = &parsed
+	}
+
 	properties := logic.Workflow{
 		Location: utils.String(location),
 		WorkflowProperties: &logic.WorkflowProperties{
-			Definition: read.WorkflowProperties.Definition,
+			Definition: definition,
 			Parameters: parameters,
 		},
 		Tags: expandTags(tags),
@@ -192,19 +328,47 @@ func resourceArmLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if props := resp.WorkflowProperties; props != nil {
-		parameters := flattenLogicAppWorkflowParameters(props.Parameters)
-		if err := d.Set("parameters", parameters); err != nil {
-			return fmt.Errorf("Error flattening `parameters`: %+v", err)
+		parameters := flattenLogicAppWorkflowParameters(d, props.Parameters)
+		if err := d.Set("parameter", parameters); err != nil {
+			return fmt.Errorf("Error flattening `parameter`: %+v", err)
 		}
 
 		d.Set("access_endpoint", props.AccessEndpoint)
 
 		if definition := props.Definition; definition != nil {
 			if v, ok := definition.(map[string]interface{}); ok {
-				schema := v["$schema"].(string)
-				version := v["contentVersion"].(string)
-				d.Set("workflow_schema", schema)
-				d.Set("workflow_version", version)
+				_, managedByDefinition := d.GetOk("definition")
+
+				// `workflow_schema`/`workflow_version` and `definition` are mutually exclusive
+				// (see `ConflictsWith` above) - when `definition` is managing the blob, its own
+				// `$schema`/`contentVersion` keys are round-tripped into `definition` below, so
+				// skip setting these ForceNew fields from them to avoid fighting over the value.
+				if !managedByDefinition {
+					if schema, ok := v["$schema"].(string); ok {
+						d.Set("workflow_schema", schema)
+					}
+
+					if version, ok := v["contentVersion"].(string); ok {
+						d.Set("workflow_version", version)
+					}
+				}
+
+				// only round-trip the full `definition` blob for users who've opted into the
+				// escape hatch - otherwise every Workflow would show a permanent diff between
+				// the unset config value and the full definition Azure returns.
+				//
+				// NOTE: if the Logic Apps service normalizes or augments the supplied
+				// definition (e.g. adding a default `runAfter` to an action), the value
+				// stored here will no longer match the user's config verbatim and `terraform
+				// plan` will show a cosmetic diff on every run - `suppressJSONDiff` only
+				// suppresses reordering/formatting differences, not genuinely different keys.
+				// Users hitting this should include the server-added fields explicitly in
+				// their `definition` to match what Azure returns.
+				if managedByDefinition {
+					if raw, err := json.Marshal(v); err == nil {
+						d.Set("definition", string(raw))
+					}
+				}
 			}
 		}
 	}
@@ -241,28 +405,361 @@ func resourceArmLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func expandLogicAppWorkflowParameters(d *schema.ResourceData) map[string]*logic.WorkflowParameter {
+func resourceArmLogicAppWorkflowMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Azure Logic App Workflow State v0; migrating `parameters` to `parameter`")
+		return migrateLogicAppWorkflowStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version for Logic App Workflow State: %d", v)
+	}
+}
+
+// migrateLogicAppWorkflowStateV0toV1 upgrades state from the old `parameters` map
+// (string -> string) to the typed `parameter` blocks that replaced it - every existing
+// entry becomes a `parameter` block of `type = "String"`. Azure never returns
+// `SecureString`/`SecureObject` values on read (see `flattenLogicAppWorkflowParameters`),
+// so a parameter that was already one of those server-side can't be recovered here either -
+// a `terraform refresh` against the old schema would have lost it the same way.
+func migrateLogicAppWorkflowStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is == nil || is.Attributes == nil {
+		return is, nil
+	}
+
+	if _, ok := is.Attributes["parameters.%"]; !ok {
+		return is, nil
+	}
+
+	names := make([]string, 0)
+	for key := range is.Attributes {
+		if key == "parameters.%" || !strings.HasPrefix(key, "parameters.") {
+			continue
+		}
+		names = append(names, strings.TrimPrefix(key, "parameters."))
+	}
+	sort.Strings(names)
+
+	is.Attributes["parameter.#"] = strconv.Itoa(len(names))
+	for i, name := range names {
+		prefix := fmt.Sprintf("parameter.%d.", i)
+		is.Attributes[prefix+"name"] = name
+		is.Attributes[prefix+"type"] = string(logic.ParameterTypeString)
+		is.Attributes[prefix+"string_value"] = is.Attributes["parameters."+name]
+		is.Attributes[prefix+"secure_string_value"] = ""
+		is.Attributes[prefix+"int_value"] = "0"
+		is.Attributes[prefix+"float_value"] = "0"
+		is.Attributes[prefix+"bool_value"] = "false"
+		is.Attributes[prefix+"array_value"] = ""
+		is.Attributes[prefix+"object_value"] = ""
+		is.Attributes[prefix+"secure_object_value"] = ""
+
+		delete(is.Attributes, "parameters."+name)
+	}
+	delete(is.Attributes, "parameters.%")
+
+	return is, nil
+}
+
+func expandLogicAppWorkflowParameters(input []interface{}) (map[string]*logic.WorkflowParameter, error) {
 	output := make(map[string]*logic.WorkflowParameter, 0)
-	input := d.Get("parameters").(map[string]interface{})
 
-	for k, v := range input {
-		output[k] = &logic.WorkflowParameter{
-			Type:  logic.ParameterTypeString,
-			Value: v.(string),
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		name := raw["name"].(string)
+		paramType := logic.ParameterType(raw["type"].(string))
+
+		if err := validateLogicAppWorkflowParameterValue(name, paramType, raw); err != nil {
+			return nil, err
+		}
+
+		parameter := logic.WorkflowParameter{
+			Type: paramType,
+		}
+
+		switch paramType {
+		case logic.ParameterTypeString:
+			parameter.Value = raw["string_value"].(string)
+		case logic.ParameterTypeSecureString:
+			parameter.Value = raw["secure_string_value"].(string)
+		case logic.ParameterTypeInt:
+			parameter.Value = raw["int_value"].(int)
+		case logic.ParameterTypeFloat:
+			parameter.Value = raw["float_value"].(float64)
+		case logic.ParameterTypeBool:
+			parameter.Value = raw["bool_value"].(bool)
+		case logic.ParameterTypeArray:
+			var value interface{}
+			if err := json.Unmarshal([]byte(raw["array_value"].(string)), &value); err != nil {
+				return nil, fmt.Errorf("Error unmarshalling `array_value` for Parameter %q: %+v", name, err)
+			}
+			parameter.Value = value
+		case logic.ParameterTypeObject:
+			var value interface{}
+			if err := json.Unmarshal([]byte(raw["object_value"].(string)), &value); err != nil {
+				return nil, fmt.Errorf("Error unmarshalling `object_value` for Parameter %q: %+v", name, err)
+			}
+			parameter.Value = value
+		case logic.ParameterTypeSecureObject:
+			var value interface{}
+			if err := json.Unmarshal([]byte(raw["secure_object_value"].(string)), &value); err != nil {
+				return nil, fmt.Errorf("Error unmarshalling `secure_object_value` for Parameter %q: %+v", name, err)
+			}
+			parameter.Value = value
+		default:
+			return nil, fmt.Errorf("Unsupported Parameter Type %q for Parameter %q", paramType, name)
+		}
+
+		output[name] = &parameter
+	}
+
+	return output, nil
+}
+
+// logicAppWorkflowParameterValueFields maps each supported Parameter `type` to the single
+// `*_value` field that should be populated for it.
+var logicAppWorkflowParameterValueFields = map[logic.ParameterType]string{
+	logic.ParameterTypeString:       "string_value",
+	logic.ParameterTypeSecureString: "secure_string_value",
+	logic.ParameterTypeInt:          "int_value",
+	logic.ParameterTypeFloat:        "float_value",
+	logic.ParameterTypeBool:         "bool_value",
+	logic.ParameterTypeArray:        "array_value",
+	logic.ParameterTypeObject:       "object_value",
+	logic.ParameterTypeSecureObject: "secure_object_value",
+}
+
+// validateLogicAppWorkflowParameterValue fails fast if a `parameter` block has a value set
+// on a `*_value` field that doesn't match its declared `type` - e.g. `type = "Int"` with
+// `string_value` populated - rather than silently sending a zero value for the real field.
+func validateLogicAppWorkflowParameterValue(name string, paramType logic.ParameterType, raw map[string]interface{}) error {
+	expected, ok := logicAppWorkflowParameterValueFields[paramType]
+	if !ok {
+		return fmt.Errorf("Unsupported Parameter Type %q for Parameter %q", paramType, name)
+	}
+
+	for field, key := range logicAppWorkflowParameterValueFields {
+		if field == paramType {
+			continue
+		}
+
+		if logicAppWorkflowParameterValueIsSet(raw[key]) {
+			return fmt.Errorf("Parameter %q is of type %q but `%s` was also set - only `%s` should be populated for this type", name, paramType, key, expected)
 		}
 	}
 
-	return output
+	return nil
+}
+
+func logicAppWorkflowParameterValueIsSet(v interface{}) bool {
+	switch value := v.(type) {
+	case string:
+		return value != ""
+	case int:
+		return value != 0
+	case float64:
+		return value != 0
+	case bool:
+		return value
+	}
+
+	return false
 }
 
-func flattenLogicAppWorkflowParameters(input map[string]*logic.WorkflowParameter) map[string]interface{} {
-	output := make(map[string]interface{}, 0)
+func flattenLogicAppWorkflowParameters(d *schema.ResourceData, input map[string]*logic.WorkflowParameter) []interface{} {
+	// Azure doesn't return the value of Secure parameters back, so we need to fall back to
+	// whatever's already in the config for those to avoid permanent diffs on every plan.
+	existing := make(map[string]map[string]interface{})
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		existing[raw["name"].(string)] = raw
+	}
 
-	for k, v := range input {
-		if v != nil {
-			output[k] = v.Value.(string)
+	output := make([]interface{}, 0)
+
+	for name, parameter := range input {
+		if parameter == nil {
+			continue
+		}
+
+		item := map[string]interface{}{
+			"name":                name,
+			"type":                string(parameter.Type),
+			"string_value":        "",
+			"secure_string_value": "",
+			"int_value":           0,
+			"float_value":         0.0,
+			"bool_value":          false,
+			"array_value":         "",
+			"object_value":        "",
+			"secure_object_value": "",
+		}
+
+		switch parameter.Type {
+		case logic.ParameterTypeString:
+			if v, ok := parameter.Value.(string); ok {
+				item["string_value"] = v
+			}
+		case logic.ParameterTypeSecureString:
+			if existingItem, ok := existing[name]; ok {
+				item["secure_string_value"] = existingItem["secure_string_value"]
+			}
+		case logic.ParameterTypeInt:
+			if v, ok := parameter.Value.(float64); ok {
+				item["int_value"] = int(v)
+			}
+		case logic.ParameterTypeFloat:
+			if v, ok := parameter.Value.(float64); ok {
+				item["float_value"] = v
+			}
+		case logic.ParameterTypeBool:
+			if v, ok := parameter.Value.(bool); ok {
+				item["bool_value"] = v
+			}
+		case logic.ParameterTypeArray:
+			if v, err := json.Marshal(parameter.Value); err == nil {
+				item["array_value"] = string(v)
+			}
+		case logic.ParameterTypeObject:
+			if v, err := json.Marshal(parameter.Value); err == nil {
+				item["object_value"] = string(v)
+			}
+		case logic.ParameterTypeSecureObject:
+			if existingItem, ok := existing[name]; ok {
+				item["secure_object_value"] = existingItem["secure_object_value"]
+			}
 		}
+
+		output = append(output, item)
 	}
 
 	return output
 }
+
+// logicAppComponentID builds the synthetic Resource ID used by the `actions`/`triggers`
+// child resources - Azure doesn't expose these as resources in their own right, so we
+// construct an ID which can be parsed back apart by parseLogicAppComponentID below.
+func logicAppComponentID(workflowID, componentType, name string) string {
+	return fmt.Sprintf("%s/%s/%s", workflowID, componentType, name)
+}
+
+func parseLogicAppComponentID(id, componentType string) (string, string, error) {
+	segment := fmt.Sprintf("/%s/", componentType)
+	parts := strings.Split(id, segment)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("Expected ID to be in the format {workflowID}%s{name} but got %q", segment, id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// retrieveLogicAppWorkflowComponent loads the parent Workflow and returns its Definition
+// decoded as a map, along with the `actions`/`triggers` sub-map that the caller should
+// mutate - callers which mutate it MUST hold the `logicAppResourceName` lock for
+// `workflowName` before calling this, and must persist any changes back via
+// `updateLogicAppWorkflowComponent`. The child resources' Read functions only read the
+// result and call this without the lock.
+func retrieveLogicAppWorkflowComponent(meta interface{}, resourceGroup, workflowName, componentKey string) (*logic.Workflow, map[string]interface{}, map[string]interface{}, error) {
+	client := meta.(*ArmClient).logicWorkflowsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	read, err := client.Get(ctx, resourceGroup, workflowName)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Error making Read request on Logic App Workflow %q (Resource Group %q): %+v", workflowName, resourceGroup, err)
+	}
+
+	if read.WorkflowProperties == nil || read.WorkflowProperties.Definition == nil {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Error parsing Logic App Workflow %q - `Definition` is nil", workflowName)
+	}
+
+	definition, ok := read.WorkflowProperties.Definition.(map[string]interface{})
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Error parsing Logic App Workflow %q - `Definition` was not a map", workflowName)
+	}
+
+	component, ok := definition[componentKey].(map[string]interface{})
+	if !ok {
+		component = make(map[string]interface{})
+	}
+
+	return &read, definition, component, nil
+}
+
+// updateLogicAppWorkflowComponent writes `component` back into the Workflow's Definition
+// under `componentKey` and persists the Workflow - callers MUST hold the
+// `logicAppResourceName` lock for `workflowName` before calling this.
+func updateLogicAppWorkflowComponent(meta interface{}, resourceGroup, workflowName string, workflow *logic.Workflow, definition map[string]interface{}, componentKey string, component map[string]interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	definition[componentKey] = component
+
+	properties := logic.Workflow{
+		Location: workflow.Location,
+		WorkflowProperties: &logic.WorkflowProperties{
+			Definition: &definition,
+			Parameters: workflow.WorkflowProperties.Parameters,
+		},
+		Tags: workflow.Tags,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, workflowName, properties); err != nil {
+		return fmt.Errorf("[ERROR] Error updating Logic App Workflow %q (Resource Group %q): %+v", workflowName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// suppressJSONDiff compares two JSON strings structurally so that a reformatted or
+// reordered - but semantically identical - `definition` doesn't cause a diff on every plan.
+func suppressJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	var oldJSON, newJSON interface{}
+
+	if err := json.Unmarshal([]byte(old), &oldJSON); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(new), &newJSON); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
+
+// validateLogicAppWorkflowDefinitionConflict fails fast when the remote Workflow's
+// `actions`/`triggers` are already being managed by `azurerm_logic_app_action_*`/
+// `azurerm_logic_app_trigger_*` child resources that the incoming `definition` doesn't
+// account for - these two ways of managing a Workflow's contents can't be mixed.
+//
+// `previous` is the `definition` held in state before this apply (empty if this is the
+// first time `definition` is being used). When `remote` still matches `previous` the user
+// is free to change the component via `parsed` - that's the whole point of managing a
+// Workflow through `definition`. It's only a conflict when `remote` differs from
+// `previous`. The Read function refreshes `definition` in state from the remote Workflow,
+// so a child resource's write that a refresh has already picked up is part of `previous`
+// and is not reported as a conflict here.
+func validateLogicAppWorkflowDefinitionConflict(remote, previous, parsed map[string]interface{}) error {
+	for _, key := range []string{"actions", "triggers"} {
+		remoteComponent, _ := remote[key].(map[string]interface{})
+		if len(remoteComponent) == 0 {
+			continue
+		}
+
+		previousComponent, _ := previous[key].(map[string]interface{})
+		if len(previousComponent) == 0 {
+			// first time `definition` is managing this key - the incoming value must
+			// account for whatever's already there before we'll let it take over.
+			parsedComponent, _ := parsed[key].(map[string]interface{})
+			if !reflect.DeepEqual(remoteComponent, parsedComponent) {
+				return fmt.Errorf("[ERROR] `definition` cannot be used together with `azurerm_logic_app_action_*`/`azurerm_logic_app_trigger_*` resources managing this Workflow's %q - manage the Workflow's contents either via `definition` or via those child resources, not both", key)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(remoteComponent, previousComponent) {
+			return fmt.Errorf("[ERROR] `definition` cannot be used together with `azurerm_logic_app_action_*`/`azurerm_logic_app_trigger_*` resources managing this Workflow's %q - manage the Workflow's contents either via `definition` or via those child resources, not both", key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceArmLogicAppWorkflow_schema(t *testing.T) {
+	resource := resourceArmLogicAppWorkflow()
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Error validating resource schema: %+v", err)
+	}
+}
+
+func TestResourceArmLogicAppWorkflow_definitionConflictsWithSchemaVersion(t *testing.T) {
+	resource := resourceArmLogicAppWorkflow()
+
+	for _, key := range []string{"workflow_schema", "workflow_version"} {
+		field := resource.Schema[key]
+		found := false
+		for _, other := range field.ConflictsWith {
+			if other == "definition" {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("Expected %q to declare `ConflictsWith` `definition`, got %#v", key, field.ConflictsWith)
+		}
+	}
+
+	definition := resource.Schema["definition"]
+	expected := map[string]bool{"workflow_schema": false, "workflow_version": false}
+	for _, other := range definition.ConflictsWith {
+		if _, ok := expected[other]; ok {
+			expected[other] = true
+		}
+	}
+	for key, found := range expected {
+		if !found {
+			t.Fatalf("Expected `definition` to declare `ConflictsWith` %q, got %#v", key, definition.ConflictsWith)
+		}
+	}
+}
+
+func TestMigrateLogicAppWorkflowStateV0toV1(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID: "some-id",
+		Attributes: map[string]string{
+			"name":           "workflow1",
+			"parameters.%":   "2",
+			"parameters.foo": "bar",
+			"parameters.baz": "qux",
+		},
+	}
+
+	migrated, err := migrateLogicAppWorkflowStateV0toV1(is)
+	if err != nil {
+		t.Fatalf("Error migrating state: %+v", err)
+	}
+
+	if _, ok := migrated.Attributes["parameters.%"]; ok {
+		t.Fatalf("Expected `parameters.%%` to be removed from state")
+	}
+
+	if migrated.Attributes["parameter.#"] != "2" {
+		t.Fatalf("Expected `parameter.#` to be `2`, got %q", migrated.Attributes["parameter.#"])
+	}
+
+	found := map[string]string{}
+	for i := 0; i < 2; i++ {
+		prefix := fmt.Sprintf("parameter.%d.", i)
+		name := migrated.Attributes[prefix+"name"]
+		if migrated.Attributes[prefix+"type"] != string(logic.ParameterTypeString) {
+			t.Fatalf("Expected migrated parameter %q to have `type` `String`", name)
+		}
+		found[name] = migrated.Attributes[prefix+"string_value"]
+	}
+
+	if found["foo"] != "bar" || found["baz"] != "qux" {
+		t.Fatalf("Expected migrated `parameter` blocks to preserve values, got %#v", found)
+	}
+}
+
+func TestExpandFlattenLogicAppWorkflowParameters_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":                "stringParam",
+			"type":                string(logic.ParameterTypeString),
+			"string_value":        "hello",
+			"secure_string_value": "",
+			"int_value":           0,
+			"float_value":         0.0,
+			"bool_value":          false,
+			"array_value":         "",
+			"object_value":        "",
+			"secure_object_value": "",
+		},
+		map[string]interface{}{
+			"name":                "intParam",
+			"type":                string(logic.ParameterTypeInt),
+			"string_value":        "",
+			"secure_string_value": "",
+			"int_value":           42,
+			"float_value":         0.0,
+			"bool_value":          false,
+			"array_value":         "",
+			"object_value":        "",
+			"secure_object_value": "",
+		},
+	}
+
+	parameters, err := expandLogicAppWorkflowParameters(input)
+	if err != nil {
+		t.Fatalf("Error expanding `parameter`: %+v", err)
+	}
+
+	if v, ok := parameters["stringParam"].Value.(string); !ok || v != "hello" {
+		t.Fatalf("Expected `stringParam` to expand to `hello`, got %#v", parameters["stringParam"].Value)
+	}
+
+	if v, ok := parameters["intParam"].Value.(int); !ok || v != 42 {
+		t.Fatalf("Expected `intParam` to expand to `42`, got %#v", parameters["intParam"].Value)
+	}
+
+	// `flatten` decodes what the API returns - ints/arrays come back through encoding/json
+	// as float64/[]interface{}, rather than the Go-native values `expand` produced above.
+	apiParameters := map[string]*logic.WorkflowParameter{
+		"stringParam": {Type: logic.ParameterTypeString, Value: "hello"},
+		"intParam":    {Type: logic.ParameterTypeInt, Value: float64(42)},
+		"arrayParam":  {Type: logic.ParameterTypeArray, Value: []interface{}{"a", "b"}},
+	}
+
+	d := resourceArmLogicAppWorkflow().TestResourceData()
+	flattened := flattenLogicAppWorkflowParameters(d, apiParameters)
+
+	byName := make(map[string]map[string]interface{})
+	for _, v := range flattened {
+		raw := v.(map[string]interface{})
+		byName[raw["name"].(string)] = raw
+	}
+
+	if v := byName["stringParam"]["string_value"].(string); v != "hello" {
+		t.Fatalf("Expected `stringParam` to flatten back to `hello`, got %q", v)
+	}
+
+	if v := byName["intParam"]["int_value"].(int); v != 42 {
+		t.Fatalf("Expected `intParam` to flatten back to `42`, got %d", v)
+	}
+
+	var arrayValue []interface{}
+	if err := json.Unmarshal([]byte(byName["arrayParam"]["array_value"].(string)), &arrayValue); err != nil {
+		t.Fatalf("Error unmarshalling flattened `array_value`: %+v", err)
+	}
+	if len(arrayValue) != 2 || arrayValue[0] != "a" || arrayValue[1] != "b" {
+		t.Fatalf("Expected `arrayParam` to flatten back to `[\"a\",\"b\"]`, got %#v", arrayValue)
+	}
+}
+
+func TestValidateLogicAppWorkflowParameterValue_mismatch(t *testing.T) {
+	raw := map[string]interface{}{
+		"string_value":        "oops",
+		"secure_string_value": "",
+		"int_value":           0,
+		"float_value":         0.0,
+		"bool_value":          false,
+		"array_value":         "",
+		"object_value":        "",
+		"secure_object_value": "",
+	}
+
+	if err := validateLogicAppWorkflowParameterValue("badParam", logic.ParameterTypeInt, raw); err == nil {
+		t.Fatalf("Expected an error when `string_value` is set for an `Int` Parameter, got none")
+	}
+}
+
+func TestValidateLogicAppWorkflowParameterValue_matching(t *testing.T) {
+	raw := map[string]interface{}{
+		"string_value":        "",
+		"secure_string_value": "",
+		"int_value":           42,
+		"float_value":         0.0,
+		"bool_value":          false,
+		"array_value":         "",
+		"object_value":        "",
+		"secure_object_value": "",
+	}
+
+	if err := validateLogicAppWorkflowParameterValue("goodParam", logic.ParameterTypeInt, raw); err != nil {
+		t.Fatalf("Expected no error when only `int_value` is set for an `Int` Parameter, got: %+v", err)
+	}
+}
+
+func TestSuppressJSONDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{`{"a":1}`, `{"a":1,"b":2}`, false},
+		{`not json`, `{"a":1}`, false},
+	}
+
+	for _, tc := range cases {
+		if actual := suppressJSONDiff("definition", tc.old, tc.new, nil); actual != tc.suppress {
+			t.Fatalf("suppressJSONDiff(%q, %q) = %t, expected %t", tc.old, tc.new, actual, tc.suppress)
+		}
+	}
+}
+
+func TestValidateLogicAppWorkflowDefinitionConflict(t *testing.T) {
+	remote := map[string]interface{}{
+		"actions": map[string]interface{}{
+			"managedByChildResource": map[string]interface{}{"type": "Http"},
+		},
+	}
+
+	noPrevious := map[string]interface{}{}
+
+	matching := map[string]interface{}{
+		"actions": remote["actions"],
+	}
+	if err := validateLogicAppWorkflowDefinitionConflict(remote, noPrevious, matching); err != nil {
+		t.Fatalf("Expected no conflict when `definition` matches the remote `actions`, got: %+v", err)
+	}
+
+	conflicting := map[string]interface{}{
+		"actions": map[string]interface{}{},
+	}
+	if err := validateLogicAppWorkflowDefinitionConflict(remote, noPrevious, conflicting); err == nil {
+		t.Fatalf("Expected a conflict error when `definition` doesn't account for remote-managed `actions`, got none")
+	}
+}
+
+func TestValidateLogicAppWorkflowDefinitionConflict_definitionOnlyUpdate(t *testing.T) {
+	// a Workflow managed entirely through `definition` (no child resources in use): the
+	// remote `actions` still match what this resource wrote last time, so the user must
+	// be free to change `actions` to something new via `parsed` without tripping the
+	// child-resource conflict check.
+	previous := map[string]interface{}{
+		"actions": map[string]interface{}{
+			"first": map[string]interface{}{"type": "Http"},
+		},
+	}
+	remote := previous
+
+	updated := map[string]interface{}{
+		"actions": map[string]interface{}{
+			"first":  map[string]interface{}{"type": "Http"},
+			"second": map[string]interface{}{"type": "Http"},
+		},
+	}
+
+	if err := validateLogicAppWorkflowDefinitionConflict(remote, previous, updated); err != nil {
+		t.Fatalf("Expected no conflict updating a `definition`-only Workflow's `actions`, got: %+v", err)
+	}
+
+	// if `remote` has drifted from what this resource last wrote (e.g. a child resource
+	// wrote underneath it), that's still a conflict regardless of what `parsed` contains.
+	driftedRemote := map[string]interface{}{
+		"actions": map[string]interface{}{
+			"first":          map[string]interface{}{"type": "Http"},
+			"addedElsewhere": map[string]interface{}{"type": "Http"},
+		},
+	}
+	if err := validateLogicAppWorkflowDefinitionConflict(driftedRemote, previous, updated); err == nil {
+		t.Fatalf("Expected a conflict error when remote `actions` has drifted from what `definition` last wrote, got none")
+	}
+}